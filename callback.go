@@ -0,0 +1,84 @@
+package freshbooks
+
+import "context"
+
+type (
+	// Callback is a subscription to a FreshBooks event notification, such
+	// as "invoice.create" or "payment.create", delivered by POSTing to Uri.
+	// See the freshbooks/webhooks subpackage for receiving them.
+	Callback struct {
+		CallbackId string `xml:"callback_id"`
+		Event      string `xml:"event"`
+		Uri        string `xml:"uri"`
+		Verified   bool   `xml:"verified"`
+	}
+	CallbackList struct {
+		Pagination
+		Callbacks []Callback `xml:"callback"`
+	}
+	CallbackCreateResponse struct {
+		StatusResponse
+		CallbackId string `xml:"callback_id"`
+	}
+)
+
+func (api *Api) ListCallbacks(request Request) (*[]Callback, *Pagination, error) {
+	return api.ListCallbacksCtx(context.Background(), request)
+}
+
+func (api *Api) ListCallbacksCtx(ctx context.Context, request Request) (*[]Callback, *Pagination, error) {
+	request.setDefaults(api, "callback.list")
+
+	response, err := api.requestCtx(ctx, request)
+	return &response.Callbacks.Callbacks, &response.Callbacks.Pagination, err
+}
+
+// RegisterCallback subscribes uri to event (e.g. "invoice.create") and
+// returns the id of the new callback.
+func (api *Api) RegisterCallback(event, uri string) (string, error) {
+	return api.RegisterCallbackCtx(context.Background(), event, uri)
+}
+
+func (api *Api) RegisterCallbackCtx(ctx context.Context, event, uri string) (string, error) {
+	request := Request{Event: event, Uri: uri}
+	request.setDefaults(api, "callback.create")
+
+	response := CallbackCreateResponse{}
+	if err := api.requestInto(ctx, request, &response); err != nil {
+		return "", err
+	}
+	if response.Status != "ok" {
+		return "", &FreshBooksError{Status: response.Status, Code: response.Code, Field: response.Field, Message: response.Error}
+	}
+	return response.CallbackId, nil
+}
+
+// DeleteCallback removes the callback subscription identified by callbackId.
+func (api *Api) DeleteCallback(callbackId string) error {
+	return api.DeleteCallbackCtx(context.Background(), callbackId)
+}
+
+func (api *Api) DeleteCallbackCtx(ctx context.Context, callbackId string) error {
+	request := Request{CallbackId: callbackId}
+	request.setDefaults(api, "callback.delete")
+
+	_, err := api.requestCtx(ctx, request)
+	return err
+}
+
+// VerifyCallback completes the one-time verification handshake FreshBooks
+// requires after a callback is registered: it must receive verifier, from
+// the "callback.verify" notification, back within 24 hours or the
+// subscription is dropped. See the freshbooks/webhooks subpackage, which
+// calls this automatically.
+func (api *Api) VerifyCallback(verifier string) error {
+	return api.VerifyCallbackCtx(context.Background(), verifier)
+}
+
+func (api *Api) VerifyCallbackCtx(ctx context.Context, verifier string) error {
+	request := Request{Verifier: verifier}
+	request.setDefaults(api, "callback.verify")
+
+	_, err := api.requestCtx(ctx, request)
+	return err
+}
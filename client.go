@@ -0,0 +1,124 @@
+package freshbooks
+
+import "context"
+
+type (
+	Client struct {
+		ClientId string `xml:"client_id"`
+		Name     string `xml:"organization"`
+	}
+	ClientList struct {
+		Pagination
+		Clients []Client `xml:"client"`
+	}
+	ClientCreateResponse struct {
+		StatusResponse
+		ClientId string `xml:"client_id"`
+	}
+	ClientOrError struct {
+		Client Client
+		Err    error
+	}
+)
+
+func (api *Api) ListClients(request Request) (*[]Client, error) {
+	return api.ListClientsCtx(context.Background(), request)
+}
+
+func (api *Api) ListClientsCtx(ctx context.Context, request Request) (*[]Client, error) {
+	request.setDefaults(api, "client.list")
+
+	response, err := api.requestCtx(ctx, request)
+	return &response.Clients.Clients, err
+}
+
+// IterClients streams every Client across all pages of request, closing the
+// returned channel once the last page has been delivered, an error occurs,
+// or ctx is cancelled.
+func (api *Api) IterClients(ctx context.Context, request Request) <-chan ClientOrError {
+	out := make(chan ClientOrError)
+
+	go func() {
+		defer close(out)
+
+		page := request
+		for {
+			page.setDefaults(api, "client.list")
+
+			response, err := api.requestCtx(ctx, page)
+			if err != nil {
+				select {
+				case out <- ClientOrError{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			for _, client := range response.Clients.Clients {
+				select {
+				case out <- ClientOrError{Client: client}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			pagination := response.Clients.Pagination
+			if pagination.Page*pagination.PerPage >= pagination.Total {
+				return
+			}
+			page.Page = pagination.Page + 1
+		}
+	}()
+
+	return out
+}
+
+func (api *Api) CreateClient(request Request) (string, error) {
+	return api.CreateClientCtx(context.Background(), request)
+}
+
+func (api *Api) CreateClientCtx(ctx context.Context, request Request) (string, error) {
+	request.setDefaults(api, "client.create")
+
+	response := ClientCreateResponse{}
+	if err := api.requestInto(ctx, request, &response); err != nil {
+		return "", err
+	}
+	if response.Status != "ok" {
+		return "", &FreshBooksError{Status: response.Status, Code: response.Code, Field: response.Field, Message: response.Error}
+	}
+	return response.ClientId, nil
+}
+
+func (api *Api) GetClient(request Request) (*Client, error) {
+	return api.GetClientCtx(context.Background(), request)
+}
+
+func (api *Api) GetClientCtx(ctx context.Context, request Request) (*Client, error) {
+	request.setDefaults(api, "client.get")
+
+	response, err := api.requestCtx(ctx, request)
+	return &response.Client, err
+}
+
+func (api *Api) UpdateClient(request Request) error {
+	return api.UpdateClientCtx(context.Background(), request)
+}
+
+func (api *Api) UpdateClientCtx(ctx context.Context, request Request) error {
+	request.setDefaults(api, "client.update")
+
+	_, err := api.requestCtx(ctx, request)
+	return err
+}
+
+func (api *Api) DeleteClient(request Request) error {
+	return api.DeleteClientCtx(context.Background(), request)
+}
+
+func (api *Api) DeleteClientCtx(ctx context.Context, request Request) error {
+	request.setDefaults(api, "client.delete")
+
+	_, err := api.requestCtx(ctx, request)
+	return err
+}
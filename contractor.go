@@ -0,0 +1,126 @@
+package freshbooks
+
+import "context"
+
+type (
+	Contractor struct {
+		// XMLName      xml.Name `xml:"contractor"`
+		ContractorId string    `xml:"contractor_id"`
+		Name         string    `xml:"name"`
+		Email        string    `xml:"email"`
+		Rate         float64   `xml:"rate"`
+		TaskId       string    `xml:"task_id"`
+		Projects     []Project `xml:"projects>project"`
+	}
+	ContractorList struct {
+		Pagination
+		Contractors []Contractor `xml:"contractor"`
+	}
+	ContractorCreateResponse struct {
+		StatusResponse
+		ContractorId string `xml:"contractor_id"`
+	}
+	ContractorOrError struct {
+		Contractor Contractor
+		Err        error
+	}
+)
+
+func (api *Api) ListContractors(request Request) (*[]Contractor, *Pagination, error) {
+	return api.ListContractorsCtx(context.Background(), request)
+}
+
+func (api *Api) ListContractorsCtx(ctx context.Context, request Request) (*[]Contractor, *Pagination, error) {
+	request.setDefaults(api, "contractor.list")
+
+	response, err := api.requestCtx(ctx, request)
+	return &response.Contractors.Contractors, &response.Contractors.Pagination, err
+}
+
+// IterContractors streams every Contractor across all pages of request,
+// closing the returned channel once the last page has been delivered, an
+// error occurs, or ctx is cancelled.
+func (api *Api) IterContractors(ctx context.Context, request Request) <-chan ContractorOrError {
+	out := make(chan ContractorOrError)
+
+	go func() {
+		defer close(out)
+
+		page := request
+		for {
+			contractors, pagination, err := api.ListContractorsCtx(ctx, page)
+			if err != nil {
+				select {
+				case out <- ContractorOrError{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			for _, contractor := range *contractors {
+				select {
+				case out <- ContractorOrError{Contractor: contractor}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if pagination.Page*pagination.PerPage >= pagination.Total {
+				return
+			}
+			page.Page = pagination.Page + 1
+		}
+	}()
+
+	return out
+}
+
+func (api *Api) CreateContractor(request Request) (string, error) {
+	return api.CreateContractorCtx(context.Background(), request)
+}
+
+func (api *Api) CreateContractorCtx(ctx context.Context, request Request) (string, error) {
+	request.setDefaults(api, "contractor.create")
+
+	response := ContractorCreateResponse{}
+	if err := api.requestInto(ctx, request, &response); err != nil {
+		return "", err
+	}
+	if response.Status != "ok" {
+		return "", &FreshBooksError{Status: response.Status, Code: response.Code, Field: response.Field, Message: response.Error}
+	}
+	return response.ContractorId, nil
+}
+
+func (api *Api) GetContractor(request Request) (*Contractor, error) {
+	return api.GetContractorCtx(context.Background(), request)
+}
+
+func (api *Api) GetContractorCtx(ctx context.Context, request Request) (*Contractor, error) {
+	request.setDefaults(api, "contractor.get")
+
+	response, err := api.requestCtx(ctx, request)
+	return &response.Contractor, err
+}
+
+func (api *Api) UpdateContractor(request Request) error {
+	return api.UpdateContractorCtx(context.Background(), request)
+}
+
+func (api *Api) UpdateContractorCtx(ctx context.Context, request Request) error {
+	request.setDefaults(api, "contractor.update")
+
+	_, err := api.requestCtx(ctx, request)
+	return err
+}
+
+func (api *Api) DeleteContractor(request Request) error {
+	return api.DeleteContractorCtx(context.Background(), request)
+}
+
+func (api *Api) DeleteContractorCtx(ctx context.Context, request Request) error {
+	request.setDefaults(api, "contractor.delete")
+
+	_, err := api.requestCtx(ctx, request)
+	return err
+}
@@ -0,0 +1,25 @@
+package freshbooks
+
+import "fmt"
+
+// FreshBooksError is returned when a request's `<response status="fail">`
+// envelope carries an `<error>`, surfacing the `<code>` and `<field>` FreshBooks
+// sends alongside it so callers can distinguish error classes (e.g.
+// validation vs. duplicate vs. not-found) instead of string-matching.
+type FreshBooksError struct {
+	Status  string
+	Code    string
+	Field   string
+	Message string
+}
+
+func (e *FreshBooksError) Error() string {
+	switch {
+	case e.Field != "":
+		return fmt.Sprintf("freshbooks: %s (code %s, field %s)", e.Message, e.Code, e.Field)
+	case e.Code != "":
+		return fmt.Sprintf("freshbooks: %s (code %s)", e.Message, e.Code)
+	default:
+		return fmt.Sprintf("freshbooks: %s", e.Message)
+	}
+}
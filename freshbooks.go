@@ -2,6 +2,7 @@ package freshbooks
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -14,14 +15,22 @@ import (
 
 type (
 	Api struct {
-		apiUrl     string
-		apiToken   string
-		oAuthToken *oauthplain.Token
-		perPage    int
-		users      []User
-		tasks      []Task
-		clients    []Client
-		projects   []Project
+		apiUrl      string
+		apiToken    string
+		oAuthToken  *oauthplain.Token
+		perPage     int
+		userAgent   string
+		logger      Logger
+		users       []User
+		tasks       []Task
+		clients     []Client
+		projects    []Project
+		RetryPolicy RetryPolicy
+		HTTPClient  *http.Client
+		// Observer, if set, is called once per HTTP round trip (including
+		// retries) with the marshaled XML request body, the raw XML
+		// response body, and any transport-level error.
+		Observer func(xmlRequest, xmlResponse []byte, err error)
 	}
 	Request struct {
 		XMLName xml.Name `xml:"request"`
@@ -30,20 +39,42 @@ type (
 		Page    int      `xml:"page"`
 
 		// optional filters used by various requests
-		Email      string     `xml:"email,omitempty"`
-		Username   string     `xml:"username,omitempty"`
-		DateFrom   *Date      `xml:"date_from,omitempty"`
-		DateTo     *Date      `xml:"date_to,omitempty"`
-		UpdateFrom *Date      `xml:"update_from,omitempty"`
-		UpdateTo   *Date      `xml:"update_to,omitempty"`
-		TaskId     string     `xml:"task_id,omitempty"`
-		ProjectId  string     `xml:"project_id,omitempty"`
-		ClientId   string     `xml:"client_id,omitempty"`
-		InvoiceId  string     `xml:"invoice_id,omitempty"`
-		TimeEntry  *TimeEntry `xml:"time_entry,omitempty"`
+		Email      string `xml:"email,omitempty"`
+		Username   string `xml:"username,omitempty"`
+		DateFrom   *Date  `xml:"date_from,omitempty"`
+		DateTo     *Date  `xml:"date_to,omitempty"`
+		UpdateFrom *Date  `xml:"update_from,omitempty"`
+		UpdateTo   *Date  `xml:"update_to,omitempty"`
+
+		// ids addressing a single resource, used by the get/update/delete methods
+		TaskId       string `xml:"task_id,omitempty"`
+		ProjectId    string `xml:"project_id,omitempty"`
+		ClientId     string `xml:"client_id,omitempty"`
+		InvoiceId    string `xml:"invoice_id,omitempty"`
+		PaymentId    string `xml:"payment_id,omitempty"`
+		ContractorId string `xml:"contractor_id,omitempty"`
+		CallbackId   string `xml:"callback_id,omitempty"`
+
+		// callback.* subscription fields
+		Event    string `xml:"event,omitempty"`
+		Uri      string `xml:"uri,omitempty"`
+		Verifier string `xml:"verifier,omitempty"`
+
+		// request bodies, one of which is set depending on Method
+		Client     *Client     `xml:"client,omitempty"`
+		Project    *Project    `xml:"project,omitempty"`
+		Task       *Task       `xml:"task,omitempty"`
+		TimeEntry  *TimeEntry  `xml:"time_entry,omitempty"`
+		Invoice    *Invoice    `xml:"invoice,omitempty"`
+		Payment    *Payment    `xml:"payment,omitempty"`
+		Contractor *Contractor `xml:"contractor,omitempty"`
 	}
 	Response struct {
-		Error       string          `xml:"error"`
+		Status string `xml:"status,attr"`
+		Error  string `xml:"error"`
+		Code   string `xml:"code"`
+		Field  string `xml:"field"`
+
 		Clients     ClientList      `xml:"clients"`
 		Projects    ProjectList     `xml:"projects"`
 		Tasks       TaskList        `xml:"tasks"`
@@ -51,32 +82,33 @@ type (
 		TimeEntries TimeEntriesList `xml:"time_entries"`
 		Contractors ContractorList  `xml:"contractors"`
 		Invoices    InvoiceList     `xml:"invoices"`
-		// Payments    PaymentList     `xml:"payments"`
+		Payments    PaymentList     `xml:"payments"`
+		Callbacks   CallbackList    `xml:"callbacks"`
+
+		Client     Client     `xml:"client"`
+		Project    Project    `xml:"project"`
+		Task       Task       `xml:"task"`
+		Invoice    Invoice    `xml:"invoice"`
+		Payment    Payment    `xml:"payment"`
+		Contractor Contractor `xml:"contractor"`
+	}
+	// StatusResponse is embedded by the typed create-response structs below;
+	// it carries the envelope fields every FreshBooks response shares.
+	StatusResponse struct {
+		Status string `xml:"status,attr"`
+		Error  string `xml:"error"`
+		Code   string `xml:"code"`
+		Field  string `xml:"field"`
 	}
 	TimeEntryResponse struct {
-		Status      string `xml:"status,attr"`
-		Error       string `xml:"error"`
-		Code        string `xml:"code"`
-		Field       string `xml:"field"`
-		TimeEntryId int    `xml:"time_entry_id"`
+		StatusResponse
+		TimeEntryId int `xml:"time_entry_id"`
 	}
 	Pagination struct {
 		Page    int `xml:"page,attr"`
 		Total   int `xml:"total,attr"`
 		PerPage int `xml:"per_page,attr"`
 	}
-	ClientList struct {
-		Pagination
-		Clients []Client `xml:"client"`
-	}
-	ProjectList struct {
-		Pagination
-		Projects []Project `xml:"project"`
-	}
-	TaskList struct {
-		Pagination
-		Tasks []Task `xml:"task"`
-	}
 	UserList struct {
 		Pagination
 		Users []User `xml:"member"`
@@ -85,34 +117,6 @@ type (
 		Pagination
 		TimeEntries []TimeEntry `xml:"time_entry"`
 	}
-	ContractorList struct {
-		Pagination
-		Contractors []Contractor `xml:"contractor"`
-	}
-	InvoiceList struct {
-		Pagination
-		Invoices []Invoice `xml:"invoice"`
-	}
-	// PaymentList struct {
-	// 	Pagination
-	// 	Payments []Payment `xml:"payments"`
-	// }
-
-	Client struct {
-		ClientId string `xml:"client_id"`
-		Name     string `xml:"organization"`
-	}
-	Project struct {
-		ProjectId string `xml:"project_id"`
-		ClientId  string `xml:"client_id"`
-		Name      string `xml:"name"`
-		TaskIds   []int  `xml:"tasks>task>task_id"`
-		UserIds   []int  `xml:"staff>staff>staff_id"`
-	}
-	Task struct {
-		TaskId string `xml:"task_id"`
-		Name   string `xml:"name"`
-	}
 	User struct {
 		UserId    string `xml:"staff_id"`
 		Email     string `xml:"email"`
@@ -128,51 +132,24 @@ type (
 		Notes       string  `xml:"notes"`
 		Hours       float64 `xml:"hours"`
 	}
-	Contractor struct {
-		// XMLName      xml.Name `xml:"contractor"`
-		ContractorId string    `xml:"contractor_id"`
-		Name         string    `xml:"name"`
-		Email        string    `xml:"email"`
-		Rate         float64   `xml:rate`
-		TaskId       string    `xml:task_id`
-		Projects     []Project `xml:projects>project`
-	}
-	Invoice struct {
-		InvoiceId         int        `xml:"invoice_id"`
-		ClientId          int        `xml:"client_id"`
-		Number            string     `xml:"number"`
-		Amount            string     `xml:"amount"`
-		CurrencyCode      string     `xml:"currency_code"`
-		AmountOutstanding string     `xml:"amount_outstanding"`
-		Status            string     `xml:"paid"`
-		Date              fbTime     `xml:"date"`
-		Updated           fbTime     `xml:"updated"`
-		Orgnization       string     `xml:"organization"`
-		LineItems         []LineItem `xml:"lines"`
-	}
-	LineItem struct {
-		LineId   int    `xml:"line_id"`
-		Amount   string `xml:"amount"`
-		Name     string `xml:"name"`
-		UnitCost string `xml:"unit_cost"`
-		Quantity int    `xml:"quantity"`
-		Type     string `xml:"type"`
-	}
-	// Payment struct {
-	// 	PaymentId    int    `xml:"payment_id"`
-	// 	InvoiceId    int    `xml:"invoice_id"`
-	// 	Date         fbTime `xml:"date"`
-	// 	Updated      fbTime `xml:"updated"`
-	// 	ClientId     int    `xml:"client_id"`
-	// 	CurrencyCode int    `xml:"currency_code"`
-	// 	Amount       string `xml:"amount"`
-	// }
+	TimeEntryOrError struct {
+		TimeEntry TimeEntry
+		Err       error
+	}
 	fbTime time.Time
+	// Date is a calendar date, used by the date_from/date_to/update_from/
+	// update_to request filters, which FreshBooks expects as "YYYY-MM-DD"
+	// rather than the timestamp format used elsewhere in responses.
+	Date time.Time
 )
 
-func NewApi(account string, token interface{}) *Api {
+func NewApi(account string, token interface{}, opts ...Option) (*Api, error) {
+	if account == "" {
+		return nil, errors.New("freshbooks: account must not be empty")
+	}
+
 	url := fmt.Sprintf("https://%s.freshbooks.com/api/2.1/xml-in", account)
-	fb := Api{apiUrl: url, perPage: 25}
+	fb := &Api{apiUrl: url, perPage: 25, RetryPolicy: DefaultRetryPolicy, HTTPClient: http.DefaultClient}
 
 	switch token.(type) {
 	case string:
@@ -180,7 +157,19 @@ func NewApi(account string, token interface{}) *Api {
 	case *oauthplain.Token:
 		fb.oAuthToken = token.(*oauthplain.Token)
 	}
-	return &fb
+
+	for _, opt := range opts {
+		opt(fb)
+	}
+
+	if fb.apiUrl == "" {
+		return nil, errors.New("freshbooks: base URL must not be empty")
+	}
+	if fb.HTTPClient == nil {
+		return nil, errors.New("freshbooks: http client must not be nil")
+	}
+
+	return fb, nil
 }
 
 func (r *Request) setDefaults(api *Api, method string) {
@@ -193,46 +182,66 @@ func (r *Request) setDefaults(api *Api, method string) {
 	r.Method = method
 }
 
-func (api *Api) ListClients(request Request) (*[]Client, error) {
-	request.setDefaults(api, "client.list")
-
-	response, err := api.request(request)
-	return &response.Clients.Clients, err
+func (api *Api) ListTimeEntries(request Request) (*[]TimeEntry, *Pagination, error) {
+	return api.ListTimeEntriesCtx(context.Background(), request)
 }
 
-func (api *Api) ListTimeEntries(request Request) (*[]TimeEntry, *Pagination, error) {
+func (api *Api) ListTimeEntriesCtx(ctx context.Context, request Request) (*[]TimeEntry, *Pagination, error) {
 	request.setDefaults(api, "time_entry.list")
 
-	response, err := api.request(request)
+	response, err := api.requestCtx(ctx, request)
 	return &response.TimeEntries.TimeEntries, &response.TimeEntries.Pagination, err
 }
 
-func (api *Api) ListContractors(request Request) (*[]Contractor, *Pagination, error) {
-	request.setDefaults(api, "contractor.list")
+// IterTimeEntries streams every TimeEntry across all pages of request,
+// closing the returned channel once the last page has been delivered, an
+// error occurs, or ctx is cancelled.
+func (api *Api) IterTimeEntries(ctx context.Context, request Request) <-chan TimeEntryOrError {
+	out := make(chan TimeEntryOrError)
 
-	response, err := api.request(request)
-	return &response.Contractors.Contractors, &response.Contractors.Pagination, err
-}
+	go func() {
+		defer close(out)
 
-func (api *Api) ListInvoices(request Request) (*[]Invoice, *Pagination, error) {
-	request.setDefaults(api, "invoice.list")
+		page := request
+		for {
+			entries, pagination, err := api.ListTimeEntriesCtx(ctx, page)
+			if err != nil {
+				select {
+				case out <- TimeEntryOrError{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
 
-	response, err := api.request(request)
-	return &response.Invoices.Invoices, &response.Invoices.Pagination, err
-}
+			for _, entry := range *entries {
+				select {
+				case out <- TimeEntryOrError{TimeEntry: entry}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if pagination.Page*pagination.PerPage >= pagination.Total {
+				return
+			}
+			page.Page = pagination.Page + 1
+		}
+	}()
 
-// func (api *Api) ListPayments(request Request) (*[]Payment, *Pagination, error) {
-// 	request.setDefaults(api, "payment.list")
-//
-// 	response, err := api.request(request)
-// 	return &response.Payments.Payments, &response.Payments.Pagination, err
-// }
+	return out
+}
 
 func (api *Api) request(request Request) (Response, error) {
+	return api.requestCtx(context.Background(), request)
+}
+
+func (api *Api) requestCtx(ctx context.Context, request Request) (Response, error) {
 	response := Response{}
-	// fmt.Printf("%#v", request)
+	if api.logger != nil {
+		api.logger.Printf("freshbooks: request %#v", request)
+	}
 
-	result, err := api.makeRawRequest(request)
+	result, err := api.makeRawRequestCtx(ctx, request)
 	if err != nil {
 		return response, err
 	}
@@ -240,19 +249,99 @@ func (api *Api) request(request Request) (Response, error) {
 	if err := xml.Unmarshal(*result, &response); err != nil {
 		return response, err
 	}
+	if api.logger != nil {
+		api.logger.Printf("freshbooks: response %#v", response)
+	}
 	if len(response.Error) > 0 {
-		return response, errors.New(response.Error)
+		return response, &FreshBooksError{Status: response.Status, Code: response.Code, Field: response.Field, Message: response.Error}
 	}
 
 	return response, nil
 }
 
+// requestInto issues request and unmarshals the raw XML response into out,
+// used by the single-object endpoints whose response envelope doesn't match
+// the list-oriented Response struct (e.g. the *_id returned by a create call).
+func (api *Api) requestInto(ctx context.Context, request Request, out interface{}) error {
+	if api.logger != nil {
+		api.logger.Printf("freshbooks: request %#v", request)
+	}
+
+	result, err := api.makeRawRequestCtx(ctx, request)
+	if err != nil {
+		return err
+	}
+	if err := xml.Unmarshal(*result, out); err != nil {
+		return err
+	}
+	if api.logger != nil {
+		api.logger.Printf("freshbooks: response %#v", out)
+	}
+	return nil
+}
+
 func (this *Api) makeRawRequest(request interface{}) (*[]byte, error) {
+	return this.makeRawRequestCtx(context.Background(), request)
+}
+
+// makeRawRequestCtx issues request, retrying according to this.RetryPolicy
+// when the response looks like a rate-limit signal: a retryable HTTP status
+// (429/503 by default) or a retryable `<error>` string in an otherwise-200
+// body. It stops retrying immediately once ctx is done.
+func (this *Api) makeRawRequestCtx(ctx context.Context, request interface{}) (*[]byte, error) {
+	policy := this.RetryPolicy
+	if policy.MaxAttempts < 1 {
+		policy = DefaultRetryPolicy
+	}
+
+	var result *[]byte
+	var err error
+	for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+		result, err = this.doRawRequest(ctx, request)
+		if err == nil && !policy.bodyRetryable(*result) {
+			return result, nil
+		}
+		if err == nil {
+			err = errors.New("freshbooks: " + policy.matchedBodyError(*result))
+		}
+
+		if attempt == policy.MaxAttempts-1 || !policy.retryable(err) {
+			return result, err
+		}
+
+		delay := policy.delay(attempt, err)
+		if policy.OnRetry != nil {
+			policy.OnRetry(attempt+1, err, delay)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+	return result, err
+}
+
+func (this *Api) doRawRequest(ctx context.Context, request interface{}) (*[]byte, error) {
 	xmlRequest, err := xml.MarshalIndent(request, "", "  ")
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("POST", this.apiUrl, bytes.NewBuffer(xmlRequest))
+
+	result, err := this.roundTrip(ctx, xmlRequest)
+	if this.Observer != nil {
+		var xmlResponse []byte
+		if result != nil {
+			xmlResponse = *result
+		}
+		this.Observer(xmlRequest, xmlResponse, err)
+	}
+	return result, err
+}
+
+func (this *Api) roundTrip(ctx context.Context, xmlRequest []byte) (*[]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", this.apiUrl, bytes.NewBuffer(xmlRequest))
 	if err != nil {
 		return nil, err
 	}
@@ -263,14 +352,26 @@ func (this *Api) makeRawRequest(request interface{}) (*[]byte, error) {
 		header := this.oAuthToken.AuthHeader()
 		req.Header.Set("Authorization", header)
 	}
+	if this.userAgent != "" {
+		req.Header.Set("User-Agent", this.userAgent)
+	}
 
-	response, err := http.DefaultClient.Do(req)
+	client := this.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	response, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer response.Body.Close()
 	if response.StatusCode != http.StatusOK {
-		return nil, errors.New(response.Status)
+		return nil, &httpStatusError{
+			StatusCode: response.StatusCode,
+			Status:     response.Status,
+			RetryAfter: parseRetryAfter(response.Header.Get("Retry-After")),
+		}
 	}
 
 	result, err := ioutil.ReadAll(response.Body)
@@ -296,3 +397,16 @@ func (t *fbTime) UnmarshalText(b []byte) error {
 	*t = fbTime(result)
 	return nil
 }
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(time.Time(d).Format("2006-01-02")), nil
+}
+
+func (d *Date) UnmarshalText(b []byte) error {
+	result, err := time.Parse("2006-01-02", string(b))
+	if err != nil {
+		return err
+	}
+	*d = Date(result)
+	return nil
+}
@@ -0,0 +1,138 @@
+package freshbooks
+
+import "context"
+
+type (
+	Invoice struct {
+		InvoiceId         int        `xml:"invoice_id"`
+		ClientId          int        `xml:"client_id"`
+		Number            string     `xml:"number"`
+		Amount            string     `xml:"amount"`
+		CurrencyCode      string     `xml:"currency_code"`
+		AmountOutstanding string     `xml:"amount_outstanding"`
+		Status            string     `xml:"paid"`
+		Date              fbTime     `xml:"date"`
+		Updated           fbTime     `xml:"updated"`
+		Orgnization       string     `xml:"organization"`
+		LineItems         []LineItem `xml:"lines"`
+	}
+	LineItem struct {
+		LineId   int    `xml:"line_id"`
+		Amount   string `xml:"amount"`
+		Name     string `xml:"name"`
+		UnitCost string `xml:"unit_cost"`
+		Quantity int    `xml:"quantity"`
+		Type     string `xml:"type"`
+	}
+	InvoiceList struct {
+		Pagination
+		Invoices []Invoice `xml:"invoice"`
+	}
+	InvoiceCreateResponse struct {
+		StatusResponse
+		InvoiceId int `xml:"invoice_id"`
+	}
+	InvoiceOrError struct {
+		Invoice Invoice
+		Err     error
+	}
+)
+
+func (api *Api) ListInvoices(request Request) (*[]Invoice, *Pagination, error) {
+	return api.ListInvoicesCtx(context.Background(), request)
+}
+
+func (api *Api) ListInvoicesCtx(ctx context.Context, request Request) (*[]Invoice, *Pagination, error) {
+	request.setDefaults(api, "invoice.list")
+
+	response, err := api.requestCtx(ctx, request)
+	return &response.Invoices.Invoices, &response.Invoices.Pagination, err
+}
+
+// IterInvoices streams every Invoice across all pages of request, closing
+// the returned channel once the last page has been delivered, an error
+// occurs, or ctx is cancelled.
+func (api *Api) IterInvoices(ctx context.Context, request Request) <-chan InvoiceOrError {
+	out := make(chan InvoiceOrError)
+
+	go func() {
+		defer close(out)
+
+		page := request
+		for {
+			invoices, pagination, err := api.ListInvoicesCtx(ctx, page)
+			if err != nil {
+				select {
+				case out <- InvoiceOrError{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			for _, invoice := range *invoices {
+				select {
+				case out <- InvoiceOrError{Invoice: invoice}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if pagination.Page*pagination.PerPage >= pagination.Total {
+				return
+			}
+			page.Page = pagination.Page + 1
+		}
+	}()
+
+	return out
+}
+
+func (api *Api) CreateInvoice(request Request) (int, error) {
+	return api.CreateInvoiceCtx(context.Background(), request)
+}
+
+func (api *Api) CreateInvoiceCtx(ctx context.Context, request Request) (int, error) {
+	request.setDefaults(api, "invoice.create")
+
+	response := InvoiceCreateResponse{}
+	if err := api.requestInto(ctx, request, &response); err != nil {
+		return 0, err
+	}
+	if response.Status != "ok" {
+		return 0, &FreshBooksError{Status: response.Status, Code: response.Code, Field: response.Field, Message: response.Error}
+	}
+	return response.InvoiceId, nil
+}
+
+func (api *Api) GetInvoice(request Request) (*Invoice, error) {
+	return api.GetInvoiceCtx(context.Background(), request)
+}
+
+func (api *Api) GetInvoiceCtx(ctx context.Context, request Request) (*Invoice, error) {
+	request.setDefaults(api, "invoice.get")
+
+	response, err := api.requestCtx(ctx, request)
+	return &response.Invoice, err
+}
+
+func (api *Api) UpdateInvoice(request Request) error {
+	return api.UpdateInvoiceCtx(context.Background(), request)
+}
+
+func (api *Api) UpdateInvoiceCtx(ctx context.Context, request Request) error {
+	request.setDefaults(api, "invoice.update")
+
+	_, err := api.requestCtx(ctx, request)
+	return err
+}
+
+func (api *Api) DeleteInvoice(request Request) error {
+	return api.DeleteInvoiceCtx(context.Background(), request)
+}
+
+func (api *Api) DeleteInvoiceCtx(ctx context.Context, request Request) error {
+	request.setDefaults(api, "invoice.delete")
+
+	_, err := api.requestCtx(ctx, request)
+	return err
+}
@@ -0,0 +1,54 @@
+package freshbooks
+
+import "net/http"
+
+// Logger is satisfied by the standard library *log.Logger, letting callers
+// plug in their own logging without this package depending on any one
+// logging framework.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// Option configures an Api constructed by NewApi.
+type Option func(*Api)
+
+// WithHTTPClient overrides the *http.Client used to send requests, e.g. to
+// install a custom Transport for tracing, request signing, or VCR-style
+// test recording. Defaults to http.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(api *Api) {
+		api.HTTPClient = client
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(api *Api) {
+		api.userAgent = userAgent
+	}
+}
+
+// WithBaseURL overrides the API URL derived from the account name, for
+// pointing the client at a sandbox or a recording proxy.
+func WithBaseURL(baseURL string) Option {
+	return func(api *Api) {
+		api.apiUrl = baseURL
+	}
+}
+
+// WithRequestLogger installs a Logger that receives the request and
+// response of every call, for debugging and observability.
+func WithRequestLogger(logger Logger) Option {
+	return func(api *Api) {
+		api.logger = logger
+	}
+}
+
+// WithObserver installs a hook that is called once per HTTP round trip
+// (including retries) with the marshaled XML request body, the raw XML
+// response body, and any transport-level error.
+func WithObserver(observer func(xmlRequest, xmlResponse []byte, err error)) Option {
+	return func(api *Api) {
+		api.Observer = observer
+	}
+}
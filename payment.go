@@ -0,0 +1,126 @@
+package freshbooks
+
+import "context"
+
+type (
+	Payment struct {
+		PaymentId    int    `xml:"payment_id"`
+		InvoiceId    int    `xml:"invoice_id"`
+		Date         fbTime `xml:"date"`
+		Updated      fbTime `xml:"updated"`
+		ClientId     int    `xml:"client_id"`
+		CurrencyCode string `xml:"currency_code"`
+		Amount       string `xml:"amount"`
+	}
+	PaymentList struct {
+		Pagination
+		Payments []Payment `xml:"payment"`
+	}
+	PaymentCreateResponse struct {
+		StatusResponse
+		PaymentId int `xml:"payment_id"`
+	}
+	PaymentOrError struct {
+		Payment Payment
+		Err     error
+	}
+)
+
+func (api *Api) ListPayments(request Request) (*[]Payment, *Pagination, error) {
+	return api.ListPaymentsCtx(context.Background(), request)
+}
+
+func (api *Api) ListPaymentsCtx(ctx context.Context, request Request) (*[]Payment, *Pagination, error) {
+	request.setDefaults(api, "payment.list")
+
+	response, err := api.requestCtx(ctx, request)
+	return &response.Payments.Payments, &response.Payments.Pagination, err
+}
+
+// IterPayments streams every Payment across all pages of request, closing
+// the returned channel once the last page has been delivered, an error
+// occurs, or ctx is cancelled.
+func (api *Api) IterPayments(ctx context.Context, request Request) <-chan PaymentOrError {
+	out := make(chan PaymentOrError)
+
+	go func() {
+		defer close(out)
+
+		page := request
+		for {
+			payments, pagination, err := api.ListPaymentsCtx(ctx, page)
+			if err != nil {
+				select {
+				case out <- PaymentOrError{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			for _, payment := range *payments {
+				select {
+				case out <- PaymentOrError{Payment: payment}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if pagination.Page*pagination.PerPage >= pagination.Total {
+				return
+			}
+			page.Page = pagination.Page + 1
+		}
+	}()
+
+	return out
+}
+
+func (api *Api) CreatePayment(request Request) (int, error) {
+	return api.CreatePaymentCtx(context.Background(), request)
+}
+
+func (api *Api) CreatePaymentCtx(ctx context.Context, request Request) (int, error) {
+	request.setDefaults(api, "payment.create")
+
+	response := PaymentCreateResponse{}
+	if err := api.requestInto(ctx, request, &response); err != nil {
+		return 0, err
+	}
+	if response.Status != "ok" {
+		return 0, &FreshBooksError{Status: response.Status, Code: response.Code, Field: response.Field, Message: response.Error}
+	}
+	return response.PaymentId, nil
+}
+
+func (api *Api) GetPayment(request Request) (*Payment, error) {
+	return api.GetPaymentCtx(context.Background(), request)
+}
+
+func (api *Api) GetPaymentCtx(ctx context.Context, request Request) (*Payment, error) {
+	request.setDefaults(api, "payment.get")
+
+	response, err := api.requestCtx(ctx, request)
+	return &response.Payment, err
+}
+
+func (api *Api) UpdatePayment(request Request) error {
+	return api.UpdatePaymentCtx(context.Background(), request)
+}
+
+func (api *Api) UpdatePaymentCtx(ctx context.Context, request Request) error {
+	request.setDefaults(api, "payment.update")
+
+	_, err := api.requestCtx(ctx, request)
+	return err
+}
+
+func (api *Api) DeletePayment(request Request) error {
+	return api.DeletePaymentCtx(context.Background(), request)
+}
+
+func (api *Api) DeletePaymentCtx(ctx context.Context, request Request) error {
+	request.setDefaults(api, "payment.delete")
+
+	_, err := api.requestCtx(ctx, request)
+	return err
+}
@@ -0,0 +1,124 @@
+package freshbooks
+
+import "context"
+
+type (
+	Project struct {
+		ProjectId string `xml:"project_id"`
+		ClientId  string `xml:"client_id"`
+		Name      string `xml:"name"`
+		TaskIds   []int  `xml:"tasks>task>task_id"`
+		UserIds   []int  `xml:"staff>staff>staff_id"`
+	}
+	ProjectList struct {
+		Pagination
+		Projects []Project `xml:"project"`
+	}
+	ProjectCreateResponse struct {
+		StatusResponse
+		ProjectId string `xml:"project_id"`
+	}
+	ProjectOrError struct {
+		Project Project
+		Err     error
+	}
+)
+
+func (api *Api) ListProjects(request Request) (*[]Project, *Pagination, error) {
+	return api.ListProjectsCtx(context.Background(), request)
+}
+
+func (api *Api) ListProjectsCtx(ctx context.Context, request Request) (*[]Project, *Pagination, error) {
+	request.setDefaults(api, "project.list")
+
+	response, err := api.requestCtx(ctx, request)
+	return &response.Projects.Projects, &response.Projects.Pagination, err
+}
+
+// IterProjects streams every Project across all pages of request, closing
+// the returned channel once the last page has been delivered, an error
+// occurs, or ctx is cancelled.
+func (api *Api) IterProjects(ctx context.Context, request Request) <-chan ProjectOrError {
+	out := make(chan ProjectOrError)
+
+	go func() {
+		defer close(out)
+
+		page := request
+		for {
+			projects, pagination, err := api.ListProjectsCtx(ctx, page)
+			if err != nil {
+				select {
+				case out <- ProjectOrError{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			for _, project := range *projects {
+				select {
+				case out <- ProjectOrError{Project: project}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if pagination.Page*pagination.PerPage >= pagination.Total {
+				return
+			}
+			page.Page = pagination.Page + 1
+		}
+	}()
+
+	return out
+}
+
+func (api *Api) CreateProject(request Request) (string, error) {
+	return api.CreateProjectCtx(context.Background(), request)
+}
+
+func (api *Api) CreateProjectCtx(ctx context.Context, request Request) (string, error) {
+	request.setDefaults(api, "project.create")
+
+	response := ProjectCreateResponse{}
+	if err := api.requestInto(ctx, request, &response); err != nil {
+		return "", err
+	}
+	if response.Status != "ok" {
+		return "", &FreshBooksError{Status: response.Status, Code: response.Code, Field: response.Field, Message: response.Error}
+	}
+	return response.ProjectId, nil
+}
+
+func (api *Api) GetProject(request Request) (*Project, error) {
+	return api.GetProjectCtx(context.Background(), request)
+}
+
+func (api *Api) GetProjectCtx(ctx context.Context, request Request) (*Project, error) {
+	request.setDefaults(api, "project.get")
+
+	response, err := api.requestCtx(ctx, request)
+	return &response.Project, err
+}
+
+func (api *Api) UpdateProject(request Request) error {
+	return api.UpdateProjectCtx(context.Background(), request)
+}
+
+func (api *Api) UpdateProjectCtx(ctx context.Context, request Request) error {
+	request.setDefaults(api, "project.update")
+
+	_, err := api.requestCtx(ctx, request)
+	return err
+}
+
+func (api *Api) DeleteProject(request Request) error {
+	return api.DeleteProjectCtx(context.Background(), request)
+}
+
+func (api *Api) DeleteProjectCtx(ctx context.Context, request Request) error {
+	request.setDefaults(api, "project.delete")
+
+	_, err := api.requestCtx(ctx, request)
+	return err
+}
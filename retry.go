@@ -0,0 +1,131 @@
+package freshbooks
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy controls how the client retries requests that fail with a
+// transient, rate-limit-shaped error. FreshBooks' classic XML API enforces
+// per-account request rate limits and signals them with an HTTP 429/503 or
+// a `<response status="fail"><error>` body rather than a dedicated status
+// code, so both are checked.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	// Jitter is the fraction of the computed delay to randomize by, e.g.
+	// 0.2 varies the delay by up to +/-20%.
+	Jitter float64
+
+	RetryableStatusCodes []int
+	// RetryableErrors are substrings matched against the `<error>` text of
+	// a failed response, e.g. "Request limit reached".
+	RetryableErrors []string
+
+	// OnRetry, if set, is called before each retry with the attempt number
+	// (1-indexed), the error that triggered the retry, and the delay about
+	// to be slept.
+	OnRetry func(attempt int, err error, delay time.Duration)
+}
+
+// DefaultRetryPolicy retries rate-limit responses a handful of times with
+// capped exponential backoff. Set Api.RetryPolicy to RetryPolicy{MaxAttempts: 1}
+// to disable retries entirely.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:          3,
+	BaseDelay:            500 * time.Millisecond,
+	MaxDelay:             30 * time.Second,
+	Jitter:               0.2,
+	RetryableStatusCodes: []int{http429, http503},
+	RetryableErrors:      []string{"Request limit reached"},
+}
+
+const (
+	http429 = 429
+	http503 = 503
+)
+
+// httpStatusError is returned by makeRawRequestCtx when the HTTP response
+// status is not 200, and carries enough detail for RetryPolicy to decide
+// whether the request is worth retrying.
+type httpStatusError struct {
+	StatusCode int
+	Status     string
+	RetryAfter time.Duration
+}
+
+func (e *httpStatusError) Error() string {
+	return e.Status
+}
+
+func (p RetryPolicy) retryable(err error) bool {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		for _, code := range p.RetryableStatusCodes {
+			if statusErr.StatusCode == code {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, substr := range p.RetryableErrors {
+		if strings.Contains(err.Error(), substr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p RetryPolicy) delay(attempt int, err error) time.Duration {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) && statusErr.RetryAfter > 0 {
+		return statusErr.RetryAfter
+	}
+
+	delay := p.BaseDelay << uint(attempt)
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		delta := float64(delay) * p.Jitter
+		delay += time.Duration(delta*2*rand.Float64() - delta)
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	return delay
+}
+
+// bodyRetryable reports whether body contains one of policy's known
+// transient `<error>` strings, without paying for a full XML unmarshal.
+func (p RetryPolicy) bodyRetryable(body []byte) bool {
+	return p.matchedBodyError(body) != ""
+}
+
+func (p RetryPolicy) matchedBodyError(body []byte) string {
+	for _, substr := range p.RetryableErrors {
+		if bytes.Contains(body, []byte(substr)) {
+			return substr
+		}
+	}
+	return ""
+}
+
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if when, err := time.Parse(time.RFC1123, header); err == nil {
+		return time.Until(when)
+	}
+	return 0
+}
@@ -0,0 +1,110 @@
+package freshbooks
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyRetryableStatusCode(t *testing.T) {
+	policy := DefaultRetryPolicy
+
+	if !policy.retryable(&httpStatusError{StatusCode: 429}) {
+		t.Error("expected 429 to be retryable")
+	}
+	if !policy.retryable(&httpStatusError{StatusCode: 503}) {
+		t.Error("expected 503 to be retryable")
+	}
+	if policy.retryable(&httpStatusError{StatusCode: 500}) {
+		t.Error("expected 500 to not be retryable")
+	}
+}
+
+func TestRetryPolicyRetryableErrorString(t *testing.T) {
+	policy := DefaultRetryPolicy
+
+	if !policy.retryable(errors.New("Request limit reached, try again later")) {
+		t.Error("expected a known transient error string to be retryable")
+	}
+	if policy.retryable(errors.New("Invalid client_id")) {
+		t.Error("expected an unrelated error string to not be retryable")
+	}
+}
+
+func TestRetryPolicyDelayExponentialBackoff(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	if got := policy.delay(0, errors.New("x")); got != 100*time.Millisecond {
+		t.Errorf("attempt 0: got %v, want %v", got, 100*time.Millisecond)
+	}
+	if got := policy.delay(1, errors.New("x")); got != 200*time.Millisecond {
+		t.Errorf("attempt 1: got %v, want %v", got, 200*time.Millisecond)
+	}
+	if got := policy.delay(2, errors.New("x")); got != 400*time.Millisecond {
+		t.Errorf("attempt 2: got %v, want %v", got, 400*time.Millisecond)
+	}
+}
+
+func TestRetryPolicyDelayCapsAtMaxDelay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+	if got := policy.delay(10, errors.New("x")); got != 300*time.Millisecond {
+		t.Errorf("got %v, want capped %v", got, 300*time.Millisecond)
+	}
+}
+
+func TestRetryPolicyDelayHonorsRetryAfter(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	err := &httpStatusError{StatusCode: 429, RetryAfter: 5 * time.Second}
+
+	if got := policy.delay(0, err); got != 5*time.Second {
+		t.Errorf("got %v, want Retry-After override of %v", got, 5*time.Second)
+	}
+}
+
+func TestRetryPolicyDelayJitterStaysInBounds(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, Jitter: 0.2}
+
+	for i := 0; i < 100; i++ {
+		got := policy.delay(0, errors.New("x"))
+		if got < 80*time.Millisecond || got > 120*time.Millisecond {
+			t.Fatalf("delay %v outside +/-20%% jitter bound of 100ms", got)
+		}
+	}
+}
+
+func TestParseRetryAfterSeconds(t *testing.T) {
+	got := parseRetryAfter("120")
+	if got != 120*time.Second {
+		t.Errorf("got %v, want %v", got, 120*time.Second)
+	}
+}
+
+func TestParseRetryAfterHTTPDate(t *testing.T) {
+	when := time.Now().Add(time.Minute).UTC()
+	got := parseRetryAfter(when.Format(time.RFC1123))
+
+	if got <= 0 || got > time.Minute+time.Second {
+		t.Errorf("got %v, want roughly %v", got, time.Minute)
+	}
+}
+
+func TestRetryPolicyBodyRetryable(t *testing.T) {
+	policy := DefaultRetryPolicy
+
+	if !policy.bodyRetryable([]byte(`<response status="fail"><error>Request limit reached</error></response>`)) {
+		t.Error("expected body containing a known transient error to be retryable")
+	}
+	if policy.bodyRetryable([]byte(`<response status="fail"><error>Invalid client_id</error></response>`)) {
+		t.Error("expected body with an unrelated error to not be retryable")
+	}
+}
+
+func TestParseRetryAfterEmptyOrInvalid(t *testing.T) {
+	if got := parseRetryAfter(""); got != 0 {
+		t.Errorf("empty header: got %v, want 0", got)
+	}
+	if got := parseRetryAfter("not-a-valid-header"); got != 0 {
+		t.Errorf("invalid header: got %v, want 0", got)
+	}
+}
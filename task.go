@@ -0,0 +1,121 @@
+package freshbooks
+
+import "context"
+
+type (
+	Task struct {
+		TaskId string `xml:"task_id"`
+		Name   string `xml:"name"`
+	}
+	TaskList struct {
+		Pagination
+		Tasks []Task `xml:"task"`
+	}
+	TaskCreateResponse struct {
+		StatusResponse
+		TaskId string `xml:"task_id"`
+	}
+	TaskOrError struct {
+		Task Task
+		Err  error
+	}
+)
+
+func (api *Api) ListTasks(request Request) (*[]Task, *Pagination, error) {
+	return api.ListTasksCtx(context.Background(), request)
+}
+
+func (api *Api) ListTasksCtx(ctx context.Context, request Request) (*[]Task, *Pagination, error) {
+	request.setDefaults(api, "task.list")
+
+	response, err := api.requestCtx(ctx, request)
+	return &response.Tasks.Tasks, &response.Tasks.Pagination, err
+}
+
+// IterTasks streams every Task across all pages of request, closing the
+// returned channel once the last page has been delivered, an error occurs,
+// or ctx is cancelled.
+func (api *Api) IterTasks(ctx context.Context, request Request) <-chan TaskOrError {
+	out := make(chan TaskOrError)
+
+	go func() {
+		defer close(out)
+
+		page := request
+		for {
+			tasks, pagination, err := api.ListTasksCtx(ctx, page)
+			if err != nil {
+				select {
+				case out <- TaskOrError{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			for _, task := range *tasks {
+				select {
+				case out <- TaskOrError{Task: task}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if pagination.Page*pagination.PerPage >= pagination.Total {
+				return
+			}
+			page.Page = pagination.Page + 1
+		}
+	}()
+
+	return out
+}
+
+func (api *Api) CreateTask(request Request) (string, error) {
+	return api.CreateTaskCtx(context.Background(), request)
+}
+
+func (api *Api) CreateTaskCtx(ctx context.Context, request Request) (string, error) {
+	request.setDefaults(api, "task.create")
+
+	response := TaskCreateResponse{}
+	if err := api.requestInto(ctx, request, &response); err != nil {
+		return "", err
+	}
+	if response.Status != "ok" {
+		return "", &FreshBooksError{Status: response.Status, Code: response.Code, Field: response.Field, Message: response.Error}
+	}
+	return response.TaskId, nil
+}
+
+func (api *Api) GetTask(request Request) (*Task, error) {
+	return api.GetTaskCtx(context.Background(), request)
+}
+
+func (api *Api) GetTaskCtx(ctx context.Context, request Request) (*Task, error) {
+	request.setDefaults(api, "task.get")
+
+	response, err := api.requestCtx(ctx, request)
+	return &response.Task, err
+}
+
+func (api *Api) UpdateTask(request Request) error {
+	return api.UpdateTaskCtx(context.Background(), request)
+}
+
+func (api *Api) UpdateTaskCtx(ctx context.Context, request Request) error {
+	request.setDefaults(api, "task.update")
+
+	_, err := api.requestCtx(ctx, request)
+	return err
+}
+
+func (api *Api) DeleteTask(request Request) error {
+	return api.DeleteTaskCtx(context.Background(), request)
+}
+
+func (api *Api) DeleteTaskCtx(ctx context.Context, request Request) error {
+	request.setDefaults(api, "task.delete")
+
+	_, err := api.requestCtx(ctx, request)
+	return err
+}
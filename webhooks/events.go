@@ -0,0 +1,27 @@
+package webhooks
+
+// Notification is a single event callback posted by FreshBooks, e.g. when
+// an invoice is created or a payment is recorded. FreshBooks' classic API
+// callbacks carry only the event name and the affected object's id, not the
+// object itself — fetch the full resource with the matching Api.Get* method
+// if you need it.
+type Notification struct {
+	Name     string
+	ObjectID string
+}
+
+// Events FreshBooks can deliver a callback for. Pass these to Api.RegisterCallback.
+const (
+	// EventCallbackVerify is posted once, right after a callback is
+	// registered, carrying a one-time verifier in the "verifier" form
+	// field. Mux completes this handshake itself; it is not dispatched to
+	// a registered Handler.
+	EventCallbackVerify = "callback.verify"
+
+	EventInvoiceCreate = "invoice.create"
+	EventInvoiceUpdate = "invoice.update"
+	EventPaymentCreate = "payment.create"
+	EventPaymentUpdate = "payment.update"
+	EventClientCreate  = "client.create"
+	EventClientUpdate  = "client.update"
+)
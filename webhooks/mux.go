@@ -0,0 +1,79 @@
+// Package webhooks receives FreshBooks event callbacks (invoice.create,
+// payment.create, etc.) and dispatches them to registered handlers. Use
+// Api.RegisterCallback from the parent package to subscribe a Mux's URL.
+//
+// FreshBooks' classic API delivers callbacks as form-encoded POSTs — not
+// XML — carrying a "name" and "object_id" field. There is no per-request
+// signature; instead, right after a callback is registered, FreshBooks
+// POSTs a one-time "callback.verify" notification with a "verifier" field
+// that must be echoed back via Api.VerifyCallback within 24 hours or the
+// subscription is dropped. Mux completes that handshake automatically.
+package webhooks
+
+import (
+	"context"
+	"net/http"
+)
+
+// Handler processes a single Notification. Returning an error causes the
+// Mux to answer the callback with a 500, so FreshBooks will retry it.
+type Handler func(ctx context.Context, notification Notification) error
+
+// Mux is an http.Handler that parses and dispatches FreshBooks webhook
+// notifications to per-event Handlers.
+type Mux struct {
+	verify   func(ctx context.Context, verifier string) error
+	handlers map[string]Handler
+}
+
+// NewMux returns a Mux. verify is called with the "verifier" field of the
+// callback.verify handshake FreshBooks sends right after a callback is
+// registered; pass Api.VerifyCallbackCtx to complete it automatically, or
+// nil to handle verification yourself.
+func NewMux(verify func(ctx context.Context, verifier string) error) *Mux {
+	return &Mux{verify: verify, handlers: make(map[string]Handler)}
+}
+
+func (m *Mux) on(event string, handler Handler) {
+	m.handlers[event] = handler
+}
+
+func (m *Mux) OnInvoiceCreate(handler Handler) { m.on(EventInvoiceCreate, handler) }
+func (m *Mux) OnInvoiceUpdate(handler Handler) { m.on(EventInvoiceUpdate, handler) }
+func (m *Mux) OnPaymentCreate(handler Handler) { m.on(EventPaymentCreate, handler) }
+func (m *Mux) OnPaymentUpdate(handler Handler) { m.on(EventPaymentUpdate, handler) }
+func (m *Mux) OnClientCreate(handler Handler)  { m.on(EventClientCreate, handler) }
+func (m *Mux) OnClientUpdate(handler Handler)  { m.on(EventClientUpdate, handler) }
+
+func (m *Mux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	name := r.FormValue("name")
+
+	if name == EventCallbackVerify {
+		if m.verify != nil {
+			if err := m.verify(r.Context(), r.FormValue("verifier")); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	handler, ok := m.handlers[name]
+	if !ok {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	notification := Notification{Name: name, ObjectID: r.FormValue("object_id")}
+	if err := handler(r.Context(), notification); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
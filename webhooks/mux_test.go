@@ -0,0 +1,107 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func postForm(t *testing.T, mux *Mux, values url.Values) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/callbacks", strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMuxCompletesVerifyHandshake(t *testing.T) {
+	var gotVerifier string
+	mux := NewMux(func(ctx context.Context, verifier string) error {
+		gotVerifier = verifier
+		return nil
+	})
+
+	rec := postForm(t, mux, url.Values{
+		"name":     {EventCallbackVerify},
+		"verifier": {"abc123"},
+	})
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if gotVerifier != "abc123" {
+		t.Errorf("verifier = %q, want %q", gotVerifier, "abc123")
+	}
+}
+
+func TestMuxVerifyHandshakeFailurePropagates(t *testing.T) {
+	mux := NewMux(func(ctx context.Context, verifier string) error {
+		return errors.New("boom")
+	})
+
+	rec := postForm(t, mux, url.Values{
+		"name":     {EventCallbackVerify},
+		"verifier": {"abc123"},
+	})
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestMuxDispatchesRegisteredHandler(t *testing.T) {
+	var got Notification
+	mux := NewMux(nil)
+	mux.OnInvoiceCreate(func(ctx context.Context, notification Notification) error {
+		got = notification
+		return nil
+	})
+
+	rec := postForm(t, mux, url.Values{
+		"name":      {EventInvoiceCreate},
+		"object_id": {"42"},
+	})
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if got.Name != EventInvoiceCreate || got.ObjectID != "42" {
+		t.Errorf("notification = %+v, want {%s 42}", got, EventInvoiceCreate)
+	}
+}
+
+func TestMuxUnregisteredEventIsAcknowledged(t *testing.T) {
+	mux := NewMux(nil)
+
+	rec := postForm(t, mux, url.Values{
+		"name":      {EventPaymentCreate},
+		"object_id": {"1"},
+	})
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestMuxHandlerErrorReturns500(t *testing.T) {
+	mux := NewMux(nil)
+	mux.OnClientCreate(func(ctx context.Context, notification Notification) error {
+		return errors.New("downstream failure")
+	})
+
+	rec := postForm(t, mux, url.Values{
+		"name":      {EventClientCreate},
+		"object_id": {"7"},
+	})
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+}